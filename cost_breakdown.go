@@ -0,0 +1,67 @@
+package ghec
+
+import "fmt"
+
+// Breakdown is the itemized cost of an Enhancement, returned by
+// Enhancement.Breakdown so a UI doesn't have to re-derive Cost's components.
+type Breakdown struct {
+	// BaseEnhancement, CardLevel, and PreviousCount identify the enhancement
+	// this breakdown describes.
+	BaseEnhancement BaseEnhancement
+	CardLevel       Level
+	PreviousCount   PreviousEnhancements
+	// Base, Level, and Previous are the cost components that sum to Total.
+	Base     Cost
+	Level    Cost
+	Previous Cost
+	// MultiTargetMultiplierApplied reports whether Base includes the
+	// rulebook's multiple-target multiplier.
+	MultiTargetMultiplierApplied bool
+	// AddAttackHexDivisor is the number of hexes Base was divided by for an
+	// EnhanceAddAttackHex enhancement, or 0 for any other enhancement.
+	AddAttackHexDivisor int
+	// Total is the enhancement's total cost, equal to Base + Level + Previous.
+	Total Cost
+}
+
+// Breakdown itemizes the enhancement's cost into its base, level, and
+// previous-enhancement components.
+func (e Enhancement) Breakdown() (Breakdown, error) {
+	base, err := e.costForBaseEnhancement()
+	if err != nil {
+		return Breakdown{}, err
+	}
+	level, err := e.rulebook.LevelCost(e.level)
+	if err != nil {
+		return Breakdown{}, err
+	}
+	previous, err := e.rulebook.PreviousCost(e.previousEnhancements)
+	if err != nil {
+		return Breakdown{}, err
+	}
+
+	b := Breakdown{
+		BaseEnhancement: e.baseEnhancement,
+		CardLevel:       e.level,
+		PreviousCount:   e.previousEnhancements,
+		Base:            base,
+		Level:           level,
+		Previous:        previous,
+		Total:           base + level + previous,
+	}
+	if e.baseEnhancement == EnhanceAddAttackHex {
+		b.AddAttackHexDivisor = e.multipleTarget
+	} else if e.multipleTarget > 1 {
+		b.MultiTargetMultiplierApplied = true
+	}
+	return b, nil
+}
+
+// String renders a human-readable explanation of the breakdown, e.g.
+// "Attack adds +1 modifier to attack at level 4 with 2 previous enhancements: 50 (base) + 75 (level) + 150 (previous) = 275g".
+func (b Breakdown) String() string {
+	return fmt.Sprintf(
+		"%s %s at level %d with %d previous enhancements: %d (base) + %d (level) + %d (previous) = %dg",
+		Title(b.BaseEnhancement), Description(b.BaseEnhancement), b.CardLevel, b.PreviousCount, b.Base, b.Level, b.Previous, b.Total,
+	)
+}
@@ -0,0 +1,83 @@
+package ghec
+
+import "testing"
+
+func TestEnhancementBreakdown(t *testing.T) {
+	tests := []struct {
+		name                    string
+		opts                    []Option
+		wantBase                Cost
+		wantLevel               Cost
+		wantPrevious            Cost
+		wantMultiplierApplied   bool
+		wantAddAttackHexDivisor int
+		wantTotal               Cost
+		wantString              string
+	}{
+		{
+			name: "attack at level 4 with 2 previous enhancements",
+			opts: []Option{
+				WithLevel(Level4),
+				WithPreviousEnhancements(PreviousEnhancements2),
+			},
+			wantBase:     50,
+			wantLevel:    75,
+			wantPrevious: 150,
+			wantTotal:    275,
+			wantString:   "Attack adds +1 modifier to attack at level 4 with 2 previous enhancements: 50 (base) + 75 (level) + 150 (previous) = 275g",
+		},
+		{
+			name:                  "shield with multiple targets",
+			opts:                  []Option{WithMultipleTarget(2)},
+			wantBase:              200,
+			wantLevel:             0,
+			wantPrevious:          0,
+			wantMultiplierApplied: true,
+			wantTotal:             200,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			be := EnhanceAttack
+			if tt.name == "shield with multiple targets" {
+				be = EnhanceShield
+			}
+			e, err := NewEnhancement(be, tt.opts...)
+			if err != nil {
+				t.Fatalf("NewEnhancement: %v", err)
+			}
+			b, err := e.Breakdown()
+			if err != nil {
+				t.Fatalf("Breakdown: %v", err)
+			}
+			if b.Base != tt.wantBase || b.Level != tt.wantLevel || b.Previous != tt.wantPrevious || b.Total != tt.wantTotal {
+				t.Errorf("Breakdown = %+v, want Base=%d Level=%d Previous=%d Total=%d",
+					b, tt.wantBase, tt.wantLevel, tt.wantPrevious, tt.wantTotal)
+			}
+			if b.MultiTargetMultiplierApplied != tt.wantMultiplierApplied {
+				t.Errorf("MultiTargetMultiplierApplied = %v, want %v", b.MultiTargetMultiplierApplied, tt.wantMultiplierApplied)
+			}
+			if tt.wantString != "" && b.String() != tt.wantString {
+				t.Errorf("String() = %q, want %q", b.String(), tt.wantString)
+			}
+		})
+	}
+}
+
+func TestEnhancementBreakdownAddAttackHex(t *testing.T) {
+	e, err := NewEnhancement(EnhanceAddAttackHex, WithMultipleTarget(2))
+	if err != nil {
+		t.Fatalf("NewEnhancement: %v", err)
+	}
+	b, err := e.Breakdown()
+	if err != nil {
+		t.Fatalf("Breakdown: %v", err)
+	}
+	if b.AddAttackHexDivisor != 2 {
+		t.Errorf("AddAttackHexDivisor = %d, want 2", b.AddAttackHexDivisor)
+	}
+	if b.Base != 100 {
+		t.Errorf("Base = %d, want 100", b.Base)
+	}
+}
@@ -2,10 +2,9 @@ package ghec
 
 import "fmt"
 
-// enhancement is a struct that holds the information needed to calculate its
-// cost. It is not exported to limit the API surface area. Its only methods are
-// With* methods to set its fields and Cost to calculate its cost.
-type enhancement struct {
+// Enhancement holds the information needed to calculate its cost. Build one
+// with NewEnhancement.
+type Enhancement struct {
 	// baseEnhancement is the base enhancement to calculate the cost.
 	// Each base enhancement has a fixed cost.
 	baseEnhancement BaseEnhancement
@@ -19,39 +18,120 @@ type enhancement struct {
 	// previousEnhancements is the number of previous enhancements on the ability
 	// card. It must be between 0 and 3.
 	previousEnhancements PreviousEnhancements
+	// rulebook supplies the cost tables used to price the enhancement.
+	rulebook Rulebook
 }
 
-// NewEnhancement creates a new enhancement to calculate its cost.
-// TODO: Use function options instead of With* methods.
-func NewEnhancement(baseEnhancement BaseEnhancement) enhancement {
-	return enhancement{
+// Option configures an Enhancement constructed by NewEnhancement.
+type Option func(*Enhancement)
+
+// WithMultipleTarget sets the number of targets for the enhancement.
+// It also sets the number of current hexes for Add Attack Hex enhancements.
+func WithMultipleTarget(multipleTarget int) Option {
+	return func(e *Enhancement) {
+		e.multipleTarget = multipleTarget
+	}
+}
+
+// WithLevel sets the level of the ability card for the enhancement.
+func WithLevel(level Level) Option {
+	return func(e *Enhancement) {
+		e.level = level
+	}
+}
+
+// WithPreviousEnhancements sets the number of previous enhancements on the
+// card.
+func WithPreviousEnhancements(previousEnhancements PreviousEnhancements) Option {
+	return func(e *Enhancement) {
+		e.previousEnhancements = previousEnhancements
+	}
+}
+
+// WithRulebook sets the Rulebook used to price the enhancement, for example
+// FrosthavenRuleset or a rulebook loaded with LoadRulebook.
+func WithRulebook(rulebook Rulebook) Option {
+	return func(e *Enhancement) {
+		e.rulebook = rulebook
+	}
+}
+
+// NewEnhancement creates a new Enhancement to calculate its cost, applying
+// opts in order. It uses GloomhavenRuleset by default; pass WithRulebook to
+// price against a different edition or a house-ruled cost table. It
+// validates the level, previous-enhancement count, and base enhancement
+// against the resulting Rulebook, so Cost cannot fail for out-of-range
+// inputs once construction succeeds -- unless the returned *Enhancement is
+// later mutated through one of the deprecated With* methods, which set
+// their field directly without validation.
+func NewEnhancement(baseEnhancement BaseEnhancement, opts ...Option) (*Enhancement, error) {
+	e := &Enhancement{
 		baseEnhancement:      baseEnhancement,
 		level:                Level1,
 		multipleTarget:       1,
 		previousEnhancements: PreviousEnhancements0,
+		rulebook:             GloomhavenRuleset{},
+	}
+	for _, opt := range opts {
+		opt(e)
 	}
+	if e.level < 1 || e.level > 9 {
+		return nil, fmt.Errorf("level must be between 1 and 9, not %d", e.level)
+	}
+	if e.previousEnhancements < 0 || e.previousEnhancements > 3 {
+		return nil, fmt.Errorf("previous enhancements must be between 0 and 3, not %d", e.previousEnhancements)
+	}
+	if e.rulebook == nil {
+		return nil, fmt.Errorf("rulebook is nil")
+	}
+	if _, err := e.costForBaseEnhancement(); err != nil {
+		return nil, err
+	}
+	return e, nil
 }
 
-// WithMultipleTarget sets the number of targets for the enhancement.
-// It also sets the number of current hexes for Add Attack Hex enhancements.
-func (e enhancement) WithMultipleTarget(multipleTarget int) enhancement {
+// WithMultipleTarget sets the number of targets for the enhancement in
+// place, and returns e for chaining.
+//
+// Deprecated: pass WithMultipleTarget(multipleTarget) to NewEnhancement
+// instead.
+func (e *Enhancement) WithMultipleTarget(multipleTarget int) *Enhancement {
 	e.multipleTarget = multipleTarget
 	return e
 }
 
-// WithLevel sets the level of the ability card for the enhancement.
-func (e enhancement) WithLevel(level Level) enhancement {
+// WithLevel sets the level of the ability card for the enhancement in
+// place, and returns e for chaining. Unlike NewEnhancement, it does not
+// validate level, so it can reintroduce the out-of-range Cost errors
+// NewEnhancement otherwise rules out.
+//
+// Deprecated: pass WithLevel(level) to NewEnhancement instead.
+func (e *Enhancement) WithLevel(level Level) *Enhancement {
 	e.level = level
 	return e
 }
 
 // WithPreviousEnhancements sets the number of previous enhancements on the
-// card.
-func (e enhancement) WithPreviousEnhancements(previousEnhancements PreviousEnhancements) enhancement {
+// card in place, and returns e for chaining. Unlike NewEnhancement, it does
+// not validate previousEnhancements, so it can reintroduce the out-of-range
+// Cost errors NewEnhancement otherwise rules out.
+//
+// Deprecated: pass WithPreviousEnhancements(previousEnhancements) to
+// NewEnhancement instead.
+func (e *Enhancement) WithPreviousEnhancements(previousEnhancements PreviousEnhancements) *Enhancement {
 	e.previousEnhancements = previousEnhancements
 	return e
 }
 
+// WithRulebook sets the Rulebook used to price the enhancement in place, and
+// returns e for chaining.
+//
+// Deprecated: pass WithRulebook(rulebook) to NewEnhancement instead.
+func (e *Enhancement) WithRulebook(rulebook Rulebook) *Enhancement {
+	e.rulebook = rulebook
+	return e
+}
+
 func DecrementPrevious(p PreviousEnhancements) PreviousEnhancements {
 	// add 4 to avoid negative numbers
 	return (p - 1 + 4) % 4
@@ -62,24 +142,16 @@ func IncrementPrevious(p PreviousEnhancements) PreviousEnhancements {
 }
 
 // Cost calculates the cost of the enhancement.
-// It returns an error if the level or previous enhancements are out of bounds,
-// since the With* methods do not validate their inputs.
-func (e enhancement) Cost() (Cost, error) {
-	if e.level < 1 || e.level > 9 {
-		return 0, fmt.Errorf("level must be between 1 and 9, not %d", e.level)
-	}
-	if e.previousEnhancements < 0 || e.previousEnhancements > 3 {
-		return 0, fmt.Errorf("previous enhancements must be between 0 and 3, not %d", e.previousEnhancements)
-	}
+func (e Enhancement) Cost() (Cost, error) {
 	baseCost, err := e.costForBaseEnhancement()
 	if err != nil {
 		return 0, err
 	}
-	levelCost, err := costForLevel(e.level)
+	levelCost, err := e.rulebook.LevelCost(e.level)
 	if err != nil {
 		return 0, err
 	}
-	previousEnhancementCost, err := costForPreviousEnhancements(e.previousEnhancements)
+	previousEnhancementCost, err := e.rulebook.PreviousCost(e.previousEnhancements)
 	if err != nil {
 		return 0, err
 	}
@@ -323,70 +395,20 @@ func List[T any](f func(BaseEnhancement) T) []T {
 }
 
 // costForBaseEnhancement is a helper function that returns the base cost for
-// the base enhancement.
-func (e enhancement) costForBaseEnhancement() (Cost, error) {
-	var cost Cost
-	switch e.baseEnhancement {
-	case EnhanceAddAttackHex:
+// the base enhancement, as priced by e.rulebook.
+func (e Enhancement) costForBaseEnhancement() (Cost, error) {
+	if e.baseEnhancement == EnhanceAddAttackHex {
 		if e.multipleTarget == 0 {
 			return 0, fmt.Errorf("e.multipleTarget is 0")
 		}
-		return Cost(200 / e.multipleTarget), nil
-	case EnhanceMove:
-		cost = 30
-	case EnhanceAttack:
-		cost = 50
-	case EnhanceRange:
-		cost = 30
-	case EnhanceShield:
-		cost = 100
-	case EnhancePush:
-		cost = 30
-	case EnhancePull:
-		cost = 30
-	case EnhancePierce:
-		cost = 30
-	case EnhanceRetaliate:
-		cost = 100
-	case EnhanceHeal:
-		cost = 30
-	case EnhanceTarget:
-		cost = 50
-	case EnhancePoison:
-		cost = 75
-	case EnhanceWound:
-		cost = 75
-	case EnhanceMuddle:
-		cost = 50
-	case EnhanceImmobilize:
-		cost = 100
-	case EnhanceDisarm:
-		cost = 150
-	case EnhanceCurse:
-		cost = 75
-	case EnhanceStrengthen:
-		cost = 50
-	case EnhanceBless:
-		cost = 50
-	case EnhanceJump:
-		cost = 50
-	case EnhanceSpecificElement:
-		cost = 100
-	case EnhanceAnyElement:
-		cost = 150
-	case EnhanceSummonsMove:
-		return Cost(100), nil
-	case EnhanceSummonsAttack:
-		return Cost(100), nil
-	case EnhanceSummonsRange:
-		return Cost(50), nil
-	case EnhanceSummonsHP:
-		return Cost(50), nil
-	default:
-		return 0, fmt.Errorf("unknown base enhancement %d", e.baseEnhancement)
+		return e.rulebook.AddAttackHexCost(e.multipleTarget)
+	}
+	cost, err := e.rulebook.BaseCost(e.baseEnhancement)
+	if err != nil {
+		return 0, err
 	}
 	if e.multipleTarget > 1 {
-		cost *= 2
+		cost *= Cost(e.rulebook.MultipleTargetMultiplier())
 	}
 	return cost, nil
 }
@@ -408,33 +430,6 @@ const (
 	Level9 Level = 9
 )
 
-// costForLevel is a helper function that returns the additional cost for the
-// ability card level.
-func costForLevel(level Level) (Cost, error) {
-	switch level {
-	case Level1:
-		return 0, nil
-	case Level2:
-		return 25, nil
-	case Level3:
-		return 50, nil
-	case Level4:
-		return 75, nil
-	case Level5:
-		return 100, nil
-	case Level6:
-		return 125, nil
-	case Level7:
-		return 150, nil
-	case Level8:
-		return 175, nil
-	case Level9:
-		return 200, nil
-	default:
-		return 0, fmt.Errorf("level must be between 1 and 9, not %d", level)
-	}
-}
-
 // PreviousEnhancements is an enum of all the valid values for previous
 // enhancements.
 type PreviousEnhancements int
@@ -447,20 +442,3 @@ const (
 	PreviousEnhancements2
 	PreviousEnhancements3
 )
-
-// costForPreviousEnhancements is a helper function that returns the
-// additional cost for the number of previous enhancements.
-func costForPreviousEnhancements(previousEnhancements PreviousEnhancements) (Cost, error) {
-	switch previousEnhancements {
-	case PreviousEnhancements0:
-		return 0, nil
-	case PreviousEnhancements1:
-		return 75, nil
-	case PreviousEnhancements2:
-		return 150, nil
-	case PreviousEnhancements3:
-		return 225, nil
-	default:
-		return 0, fmt.Errorf("previous enhancements must be between 0 and 3, not %d", previousEnhancements)
-	}
-}
@@ -0,0 +1,56 @@
+package ghec
+
+import "fmt"
+
+// FrosthavenRuleset is the Rulebook for Frosthaven. Frosthaven reuses most of
+// the Gloomhaven cost table but makes elemental enhancements cheaper and
+// scales the previous-enhancement surcharge more steeply.
+type FrosthavenRuleset struct{}
+
+// BaseCost returns the Frosthaven base cost for be.
+func (FrosthavenRuleset) BaseCost(be BaseEnhancement) (Cost, error) {
+	switch be {
+	case EnhanceSpecificElement:
+		return 75, nil
+	case EnhanceAnyElement:
+		return 125, nil
+	default:
+		return GloomhavenRuleset{}.BaseCost(be)
+	}
+}
+
+// LevelCost returns the Frosthaven level surcharge for level, which matches
+// first-edition Gloomhaven.
+func (FrosthavenRuleset) LevelCost(level Level) (Cost, error) {
+	return GloomhavenRuleset{}.LevelCost(level)
+}
+
+// PreviousCost returns the Frosthaven previous-enhancement surcharge for
+// previous. Frosthaven escalates faster than first-edition Gloomhaven.
+func (FrosthavenRuleset) PreviousCost(previous PreviousEnhancements) (Cost, error) {
+	switch previous {
+	case PreviousEnhancements0:
+		return 0, nil
+	case PreviousEnhancements1:
+		return 100, nil
+	case PreviousEnhancements2:
+		return 200, nil
+	case PreviousEnhancements3:
+		return 300, nil
+	default:
+		return 0, fmt.Errorf("previous enhancements must be between 0 and 3, not %d", previous)
+	}
+}
+
+// MultipleTargetMultiplier returns the Frosthaven multiple-target
+// multiplier, which matches first-edition Gloomhaven.
+func (FrosthavenRuleset) MultipleTargetMultiplier() int {
+	return 2
+}
+
+// AddAttackHexCost returns the Frosthaven cost of adding an attack hex,
+// given the current number of hexes. Frosthaven uses the same numerator as
+// first-edition Gloomhaven.
+func (FrosthavenRuleset) AddAttackHexCost(hexes int) (Cost, error) {
+	return GloomhavenRuleset{}.AddAttackHexCost(hexes)
+}
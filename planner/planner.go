@@ -0,0 +1,196 @@
+// Package planner chooses which enhancements to apply to an ability card
+// under a gold budget.
+package planner
+
+import (
+	"fmt"
+	"sort"
+
+	ghec "github.com/jluckyiv/ghuc"
+)
+
+// Slot is a candidate enhancement slot on an ability card.
+type Slot struct {
+	// BaseEnhancement is the enhancement this slot would apply.
+	BaseEnhancement ghec.BaseEnhancement
+	// AllowedLevels is the set of card levels this slot may be priced at.
+	// Optimize always prices a chosen slot at whichever allowed level is
+	// cheapest, since value only depends on BaseEnhancement.
+	AllowedLevels []ghec.Level
+	// CurrentMultiTarget is the enhancement's multiple-target setting (and,
+	// for EnhanceAddAttackHex, its current number of hexes).
+	CurrentMultiTarget int
+}
+
+// Application is one enhancement chosen by Optimize, in the order it should
+// be applied to the card.
+type Application struct {
+	// SlotIndex is the index of this application's Slot in the slots slice
+	// passed to Optimize.
+	SlotIndex int
+	// Level is the level Optimize chose to price this slot at.
+	Level ghec.Level
+	// Cost is this application's cost, including the previous-enhancement
+	// surcharge in effect when it is applied.
+	Cost ghec.Cost
+}
+
+// Plan is the result of Optimize: the chosen enhancements, in application
+// order, and their total cost.
+type Plan struct {
+	Applications []Application
+	TotalCost    ghec.Cost
+}
+
+// maxPreviousEnhancements is the highest previous-enhancement tier a
+// Rulebook prices; applications beyond this count are priced at this tier.
+const maxPreviousEnhancements = ghec.PreviousEnhancements3
+
+// Optimize chooses the subset of slots, and a level for each chosen slot,
+// that maximizes the sum of value(slot.BaseEnhancement) without exceeding
+// budget, given a shared PreviousEnhancements counter that increments with
+// every applied enhancement.
+//
+// The previous-enhancement surcharge is a function of how many enhancements
+// were already applied, not of which enhancement occupies which position,
+// so Plan.TotalCost for a fixed subset is the same regardless of
+// application order (see the package tests for this invariant). Optimize
+// still evaluates candidate slots cheapest-first: it doesn't change
+// TotalCost, but it does produce a Plan.Applications sequence with
+// non-decreasing Application.Cost whenever the rulebook's
+// previous-enhancement surcharge does not decrease from one tier to the
+// next (as with GloomhavenRuleset and FrosthavenRuleset), which reads better
+// than an arbitrary order. This turns the search into a straightforward 0/1 knapsack: a DP
+// over (slotIndex, previousEnhancementsSoFar, remainingBudget), memoized
+// because the same state is reachable via many different subsets of
+// earlier slots.
+func Optimize(slots []Slot, budget ghec.Cost, value func(ghec.BaseEnhancement) int, rb ghec.Rulebook) (Plan, error) {
+	if rb == nil {
+		return Plan{}, fmt.Errorf("rulebook is nil")
+	}
+	items := make([]item, len(slots))
+	for i, slot := range slots {
+		if len(slot.AllowedLevels) == 0 {
+			return Plan{}, fmt.Errorf("slot %d has no allowed levels", i)
+		}
+		it, err := newItem(i, slot, value, rb)
+		if err != nil {
+			return Plan{}, fmt.Errorf("slot %d: %w", i, err)
+		}
+		items[i] = it
+	}
+	sort.SliceStable(items, func(a, b int) bool {
+		return items[a].baseCost < items[b].baseCost
+	})
+
+	s := &solver{items: items, rb: rb, memo: make(map[state]result)}
+	best := s.solve(0, 0, budget)
+
+	plan := Plan{TotalCost: best.cost}
+	for _, app := range best.applications {
+		plan.Applications = append(plan.Applications, app)
+	}
+	return plan, nil
+}
+
+// item is a Slot with its originating index and its cheapest allowed level
+// precomputed, since value does not depend on level.
+type item struct {
+	slotIndex int
+	be        ghec.BaseEnhancement
+	level     ghec.Level
+	baseCost  ghec.Cost
+	value     int
+}
+
+func newItem(slotIndex int, slot Slot, value func(ghec.BaseEnhancement) int, rb ghec.Rulebook) (item, error) {
+	best := item{slotIndex: slotIndex, be: slot.BaseEnhancement, value: value(slot.BaseEnhancement)}
+	found := false
+	for _, level := range slot.AllowedLevels {
+		e, err := ghec.NewEnhancement(
+			slot.BaseEnhancement,
+			ghec.WithLevel(level),
+			ghec.WithMultipleTarget(slot.CurrentMultiTarget),
+			ghec.WithRulebook(rb),
+		)
+		if err != nil {
+			return item{}, err
+		}
+		// Use Base+Level only, not Cost/Breakdown's Previous component: the
+		// solver adds the previous-enhancement surcharge itself for the
+		// actual DP state, and Previous here would reflect only the
+		// default PreviousEnhancements0 baseline, double-counting it.
+		b, err := e.Breakdown()
+		if err != nil {
+			return item{}, err
+		}
+		cost := b.Base + b.Level
+		if !found || cost < best.baseCost {
+			best.baseCost = cost
+			best.level = level
+			found = true
+		}
+	}
+	return best, nil
+}
+
+// state is a DP memoization key.
+type state struct {
+	index    int
+	previous ghec.PreviousEnhancements
+	budget   ghec.Cost
+}
+
+// result is the best outcome found for a given state: the total value and
+// cost achieved, and the applications (in application order) that achieve
+// it.
+type result struct {
+	value        int
+	cost         ghec.Cost
+	applications []Application
+}
+
+type solver struct {
+	items []item
+	rb    ghec.Rulebook
+	memo  map[state]result
+}
+
+func (s *solver) solve(index int, previous ghec.PreviousEnhancements, budget ghec.Cost) result {
+	if index == len(s.items) {
+		return result{}
+	}
+	key := state{index: index, previous: previous, budget: budget}
+	if cached, ok := s.memo[key]; ok {
+		return cached
+	}
+
+	best := s.solve(index+1, previous, budget)
+
+	it := s.items[index]
+	previousCost, err := s.rb.PreviousCost(previous)
+	if err == nil {
+		cost := it.baseCost + previousCost
+		if cost <= budget {
+			rest := s.solve(index+1, incrementPrevious(previous), budget-cost)
+			value := it.value + rest.value
+			total := cost + rest.cost
+			if value > best.value || (value == best.value && total < best.cost) {
+				applications := make([]Application, 0, len(rest.applications)+1)
+				applications = append(applications, Application{SlotIndex: it.slotIndex, Level: it.level, Cost: cost})
+				applications = append(applications, rest.applications...)
+				best = result{value: value, cost: total, applications: applications}
+			}
+		}
+	}
+
+	s.memo[key] = best
+	return best
+}
+
+func incrementPrevious(p ghec.PreviousEnhancements) ghec.PreviousEnhancements {
+	if p >= maxPreviousEnhancements {
+		return maxPreviousEnhancements
+	}
+	return p + 1
+}
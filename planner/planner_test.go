@@ -0,0 +1,119 @@
+package planner
+
+import (
+	"testing"
+
+	ghec "github.com/jluckyiv/ghuc"
+)
+
+// applicationOrderCost returns the total cost of applying enhancements with
+// the given base costs in the given order, given the PreviousEnhancements
+// surcharge escalates by one tier per application.
+func applicationOrderCost(t *testing.T, baseCosts []ghec.Cost) ghec.Cost {
+	t.Helper()
+	rb := ghec.GloomhavenRuleset{}
+	previous := ghec.PreviousEnhancements(0)
+	var total ghec.Cost
+	for _, baseCost := range baseCosts {
+		surcharge, err := rb.PreviousCost(previous)
+		if err != nil {
+			t.Fatalf("PreviousCost(%d): %v", previous, err)
+		}
+		total += baseCost + surcharge
+		previous = incrementPrevious(previous)
+	}
+	return total
+}
+
+// TestApplicationOrderDoesNotAffectTotalCost pins the actual invariant of
+// this cost model: PreviousCost depends only on how many enhancements were
+// already applied, not on which enhancement occupies which position, so the
+// total cost of a fixed set of enhancements is the same no matter what order
+// they're applied in. Optimize still applies cheapest-first (see
+// TestOptimizeOrdersApplicationsCheapestFirst), but that's for a
+// nicer-looking Plan.Applications sequence, not because it changes
+// Plan.TotalCost.
+func TestApplicationOrderDoesNotAffectTotalCost(t *testing.T) {
+	costs := []ghec.Cost{30, 50, 100}
+	want := applicationOrderCost(t, []ghec.Cost{costs[0], costs[1], costs[2]})
+	otherOrders := [][]ghec.Cost{
+		{costs[2], costs[1], costs[0]},
+		{costs[1], costs[0], costs[2]},
+		{costs[2], costs[0], costs[1]},
+	}
+	for _, order := range otherOrders {
+		if got := applicationOrderCost(t, order); got != want {
+			t.Errorf("order %v cost %d, want %d (same as any other order of the same set)", order, got, want)
+		}
+	}
+}
+
+// TestOptimizeOrdersApplicationsCheapestFirst checks the one property
+// cheapest-first evaluation actually buys: a non-decreasing
+// Application.Cost sequence in the output, for a budget large enough that
+// every slot is chosen.
+func TestOptimizeOrdersApplicationsCheapestFirst(t *testing.T) {
+	rb := ghec.GloomhavenRuleset{}
+	slots := []Slot{
+		{BaseEnhancement: ghec.EnhanceShield, AllowedLevels: []ghec.Level{ghec.Level1}, CurrentMultiTarget: 1}, // base cost 100
+		{BaseEnhancement: ghec.EnhanceMove, AllowedLevels: []ghec.Level{ghec.Level1}, CurrentMultiTarget: 1},   // base cost 30
+		{BaseEnhancement: ghec.EnhanceAttack, AllowedLevels: []ghec.Level{ghec.Level1}, CurrentMultiTarget: 1}, // base cost 50
+	}
+	value := func(ghec.BaseEnhancement) int { return 1 }
+
+	plan, err := Optimize(slots, 500, value, rb)
+	if err != nil {
+		t.Fatalf("Optimize: %v", err)
+	}
+	if len(plan.Applications) != len(slots) {
+		t.Fatalf("len(Applications) = %d, want %d", len(plan.Applications), len(slots))
+	}
+	for i := 1; i < len(plan.Applications); i++ {
+		if plan.Applications[i-1].Cost > plan.Applications[i].Cost {
+			t.Errorf("Applications not cheapest-first: %+v", plan.Applications)
+		}
+	}
+}
+
+func TestOptimizePrefersHigherValueWithinBudget(t *testing.T) {
+	rb := ghec.GloomhavenRuleset{}
+	slots := []Slot{
+		{BaseEnhancement: ghec.EnhanceMove, AllowedLevels: []ghec.Level{ghec.Level1}, CurrentMultiTarget: 1},   // base cost 30
+		{BaseEnhancement: ghec.EnhanceAttack, AllowedLevels: []ghec.Level{ghec.Level1}, CurrentMultiTarget: 1}, // base cost 50
+		{BaseEnhancement: ghec.EnhanceShield, AllowedLevels: []ghec.Level{ghec.Level1}, CurrentMultiTarget: 1}, // base cost 100
+	}
+	value := func(be ghec.BaseEnhancement) int {
+		switch be {
+		case ghec.EnhanceMove:
+			return 1
+		case ghec.EnhanceAttack:
+			return 3
+		case ghec.EnhanceShield:
+			return 2
+		default:
+			return 0
+		}
+	}
+
+	plan, err := Optimize(slots, 160, value, rb)
+	if err != nil {
+		t.Fatalf("Optimize: %v", err)
+	}
+
+	var gotValue int
+	for _, app := range plan.Applications {
+		gotValue += value(slots[app.SlotIndex].BaseEnhancement)
+	}
+	if gotValue != 4 {
+		t.Errorf("total value = %d, want 4 (Move + Attack)", gotValue)
+	}
+	if plan.TotalCost > 160 {
+		t.Errorf("TotalCost = %d, exceeds budget 160", plan.TotalCost)
+	}
+	if len(plan.Applications) != 2 {
+		t.Fatalf("len(Applications) = %d, want 2", len(plan.Applications))
+	}
+	if plan.Applications[0].Cost > plan.Applications[1].Cost {
+		t.Errorf("Applications not cheapest-first: %+v", plan.Applications)
+	}
+}
@@ -0,0 +1,144 @@
+package ghec
+
+import "fmt"
+
+// Rulebook abstracts the edition-specific cost tables used to price an
+// enhancement. Gloomhaven, Frosthaven, and any house-ruled variant can each
+// supply their own Rulebook so the enhancement logic in this package does
+// not need to know which edition it is pricing.
+type Rulebook interface {
+	// BaseCost returns the base cost for the given base enhancement, before
+	// the level and previous-enhancement surcharges are added.
+	BaseCost(be BaseEnhancement) (Cost, error)
+	// LevelCost returns the additional cost for the ability card's level.
+	LevelCost(level Level) (Cost, error)
+	// PreviousCost returns the additional cost for the number of previous
+	// enhancements already on the ability card.
+	PreviousCost(previous PreviousEnhancements) (Cost, error)
+	// MultipleTargetMultiplier returns the multiplier applied to BaseCost
+	// when an enhancement affects multiple targets.
+	MultipleTargetMultiplier() int
+	// AddAttackHexCost returns the cost of the Add Attack Hex enhancement
+	// given the current number of attack hexes.
+	AddAttackHexCost(hexes int) (Cost, error)
+}
+
+// GloomhavenRuleset is the Rulebook for first-edition Gloomhaven. It is the
+// default used by NewEnhancement.
+type GloomhavenRuleset struct{}
+
+// BaseCost returns the first-edition Gloomhaven base cost for be.
+func (GloomhavenRuleset) BaseCost(be BaseEnhancement) (Cost, error) {
+	switch be {
+	case EnhanceMove:
+		return 30, nil
+	case EnhanceAttack:
+		return 50, nil
+	case EnhanceRange:
+		return 30, nil
+	case EnhanceShield:
+		return 100, nil
+	case EnhancePush:
+		return 30, nil
+	case EnhancePull:
+		return 30, nil
+	case EnhancePierce:
+		return 30, nil
+	case EnhanceRetaliate:
+		return 100, nil
+	case EnhanceHeal:
+		return 30, nil
+	case EnhanceTarget:
+		return 50, nil
+	case EnhancePoison:
+		return 75, nil
+	case EnhanceWound:
+		return 75, nil
+	case EnhanceMuddle:
+		return 50, nil
+	case EnhanceImmobilize:
+		return 100, nil
+	case EnhanceDisarm:
+		return 150, nil
+	case EnhanceCurse:
+		return 75, nil
+	case EnhanceStrengthen:
+		return 50, nil
+	case EnhanceBless:
+		return 50, nil
+	case EnhanceJump:
+		return 50, nil
+	case EnhanceSpecificElement:
+		return 100, nil
+	case EnhanceAnyElement:
+		return 150, nil
+	case EnhanceSummonsMove:
+		return 100, nil
+	case EnhanceSummonsAttack:
+		return 100, nil
+	case EnhanceSummonsRange:
+		return 50, nil
+	case EnhanceSummonsHP:
+		return 50, nil
+	default:
+		return 0, fmt.Errorf("unknown base enhancement %d", be)
+	}
+}
+
+// LevelCost returns the first-edition Gloomhaven level surcharge for level.
+func (GloomhavenRuleset) LevelCost(level Level) (Cost, error) {
+	switch level {
+	case Level1:
+		return 0, nil
+	case Level2:
+		return 25, nil
+	case Level3:
+		return 50, nil
+	case Level4:
+		return 75, nil
+	case Level5:
+		return 100, nil
+	case Level6:
+		return 125, nil
+	case Level7:
+		return 150, nil
+	case Level8:
+		return 175, nil
+	case Level9:
+		return 200, nil
+	default:
+		return 0, fmt.Errorf("level must be between 1 and 9, not %d", level)
+	}
+}
+
+// PreviousCost returns the first-edition Gloomhaven previous-enhancement
+// surcharge for previous.
+func (GloomhavenRuleset) PreviousCost(previous PreviousEnhancements) (Cost, error) {
+	switch previous {
+	case PreviousEnhancements0:
+		return 0, nil
+	case PreviousEnhancements1:
+		return 75, nil
+	case PreviousEnhancements2:
+		return 150, nil
+	case PreviousEnhancements3:
+		return 225, nil
+	default:
+		return 0, fmt.Errorf("previous enhancements must be between 0 and 3, not %d", previous)
+	}
+}
+
+// MultipleTargetMultiplier returns the first-edition Gloomhaven
+// multiple-target multiplier.
+func (GloomhavenRuleset) MultipleTargetMultiplier() int {
+	return 2
+}
+
+// AddAttackHexCost returns the first-edition Gloomhaven cost of adding an
+// attack hex, given the current number of hexes.
+func (GloomhavenRuleset) AddAttackHexCost(hexes int) (Cost, error) {
+	if hexes == 0 {
+		return 0, fmt.Errorf("hexes is 0")
+	}
+	return Cost(200 / hexes), nil
+}
@@ -0,0 +1,223 @@
+package ghec
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/BurntSushi/toml"
+)
+
+// baseEnhancementNames maps the document key used in a loaded rulebook file
+// to the BaseEnhancement it prices. EnhanceAddAttackHex is intentionally
+// absent: its cost comes from AddAttackHexNumerator, not the base table.
+var baseEnhancementNames = map[string]BaseEnhancement{
+	"Move":            EnhanceMove,
+	"Jump":            EnhanceJump,
+	"Attack":          EnhanceAttack,
+	"Range":           EnhanceRange,
+	"Target":          EnhanceTarget,
+	"Heal":            EnhanceHeal,
+	"Shield":          EnhanceShield,
+	"Retaliate":       EnhanceRetaliate,
+	"Strengthen":      EnhanceStrengthen,
+	"Muddle":          EnhanceMuddle,
+	"Disarm":          EnhanceDisarm,
+	"Pierce":          EnhancePierce,
+	"Poison":          EnhancePoison,
+	"Wound":           EnhanceWound,
+	"Push":            EnhancePush,
+	"Pull":            EnhancePull,
+	"Immobilize":      EnhanceImmobilize,
+	"Curse":           EnhanceCurse,
+	"Bless":           EnhanceBless,
+	"SpecificElement": EnhanceSpecificElement,
+	"AnyElement":      EnhanceAnyElement,
+	"SummonsMove":     EnhanceSummonsMove,
+	"SummonsAttack":   EnhanceSummonsAttack,
+	"SummonsRange":    EnhanceSummonsRange,
+	"SummonsHP":       EnhanceSummonsHP,
+}
+
+// rulebookDocument is the TOML/JSON shape accepted by LoadRulebook. Levels is
+// indexed by level-1 (9 entries, for Level1..Level9) and Previous is indexed
+// directly (4 entries, for PreviousEnhancements0..3).
+type rulebookDocument struct {
+	MultipleTargetMultiplier int            `toml:"multiple_target_multiplier" json:"multiple_target_multiplier"`
+	AddAttackHexNumerator    int            `toml:"add_attack_hex_numerator" json:"add_attack_hex_numerator"`
+	Base                     map[string]int `toml:"base" json:"base"`
+	Levels                   []int          `toml:"levels" json:"levels"`
+	Previous                 []int          `toml:"previous" json:"previous"`
+}
+
+// RulebookValidationError reports every problem found while validating a
+// loaded rulebook document, rather than only the first.
+type RulebookValidationError struct {
+	Problems []string
+}
+
+func (e *RulebookValidationError) Error() string {
+	msg := fmt.Sprintf("invalid rulebook: %d problem(s) found:", len(e.Problems))
+	for _, problem := range e.Problems {
+		msg += "\n  - " + problem
+	}
+	return msg
+}
+
+// fileRulebook is a Rulebook loaded from a rulebookDocument.
+type fileRulebook struct {
+	multipleTargetMultiplier int
+	addAttackHexNumerator    int
+	base                     map[BaseEnhancement]Cost
+	levels                   [9]Cost
+	previous                 [4]Cost
+}
+
+// LoadRulebook parses a TOML (or, failing that, JSON) rulebook document from
+// r and validates it. On validation failure, the returned error is a
+// *RulebookValidationError listing every problem found.
+func LoadRulebook(r io.Reader) (Rulebook, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading rulebook: %w", err)
+	}
+
+	var doc rulebookDocument
+	tomlErr := toml.Unmarshal(data, &doc)
+	if tomlErr != nil {
+		if jsonErr := json.Unmarshal(data, &doc); jsonErr != nil {
+			return nil, fmt.Errorf("parsing rulebook as TOML (%v) or JSON (%v)", tomlErr, jsonErr)
+		}
+	}
+
+	return newFileRulebook(doc)
+}
+
+// LoadRulebookFile opens path and calls LoadRulebook on its contents.
+func LoadRulebookFile(path string) (Rulebook, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening rulebook file: %w", err)
+	}
+	defer f.Close()
+
+	rb, err := LoadRulebook(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return rb, nil
+}
+
+// newFileRulebook validates doc and converts it into a fileRulebook. It
+// collects every problem it finds before returning, rather than stopping at
+// the first one.
+func newFileRulebook(doc rulebookDocument) (Rulebook, error) {
+	var problems []string
+
+	base := make(map[BaseEnhancement]Cost, len(baseEnhancementNames))
+	for name, be := range baseEnhancementNames {
+		cost, ok := doc.Base[name]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("missing base cost for %q", name))
+			continue
+		}
+		if cost < 0 {
+			problems = append(problems, fmt.Sprintf("negative base cost for %q: %d", name, cost))
+			continue
+		}
+		base[be] = Cost(cost)
+	}
+	for name := range doc.Base {
+		if _, ok := baseEnhancementNames[name]; !ok {
+			problems = append(problems, fmt.Sprintf("unknown base enhancement name %q", name))
+		}
+	}
+
+	var levels [9]Cost
+	if len(doc.Levels) != 9 {
+		problems = append(problems, fmt.Sprintf("levels must have 9 entries (for levels 1-9), got %d", len(doc.Levels)))
+	} else {
+		for i, cost := range doc.Levels {
+			if cost < 0 {
+				problems = append(problems, fmt.Sprintf("negative level cost for level %d: %d", i+1, cost))
+				continue
+			}
+			levels[i] = Cost(cost)
+		}
+	}
+
+	var previous [4]Cost
+	if len(doc.Previous) != 4 {
+		problems = append(problems, fmt.Sprintf("previous must have 4 entries (for 0-3 previous enhancements), got %d", len(doc.Previous)))
+	} else {
+		for i, cost := range doc.Previous {
+			if cost < 0 {
+				problems = append(problems, fmt.Sprintf("negative previous-enhancement cost for %d previous: %d", i, cost))
+				continue
+			}
+			previous[i] = Cost(cost)
+		}
+	}
+
+	if doc.MultipleTargetMultiplier <= 0 {
+		problems = append(problems, fmt.Sprintf("multiple_target_multiplier must be positive, got %d", doc.MultipleTargetMultiplier))
+	}
+	if doc.AddAttackHexNumerator <= 0 {
+		problems = append(problems, fmt.Sprintf("add_attack_hex_numerator must be positive, got %d", doc.AddAttackHexNumerator))
+	}
+
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return nil, &RulebookValidationError{Problems: problems}
+	}
+
+	return &fileRulebook{
+		multipleTargetMultiplier: doc.MultipleTargetMultiplier,
+		addAttackHexNumerator:    doc.AddAttackHexNumerator,
+		base:                     base,
+		levels:                   levels,
+		previous:                 previous,
+	}, nil
+}
+
+// BaseCost returns the loaded base cost for be.
+func (rb *fileRulebook) BaseCost(be BaseEnhancement) (Cost, error) {
+	cost, ok := rb.base[be]
+	if !ok {
+		return 0, fmt.Errorf("unknown base enhancement %d", be)
+	}
+	return cost, nil
+}
+
+// LevelCost returns the loaded level surcharge for level.
+func (rb *fileRulebook) LevelCost(level Level) (Cost, error) {
+	if level < 1 || level > 9 {
+		return 0, fmt.Errorf("level must be between 1 and 9, not %d", level)
+	}
+	return rb.levels[level-1], nil
+}
+
+// PreviousCost returns the loaded previous-enhancement surcharge for
+// previous.
+func (rb *fileRulebook) PreviousCost(previous PreviousEnhancements) (Cost, error) {
+	if previous < 0 || previous > 3 {
+		return 0, fmt.Errorf("previous enhancements must be between 0 and 3, not %d", previous)
+	}
+	return rb.previous[previous], nil
+}
+
+// MultipleTargetMultiplier returns the loaded multiple-target multiplier.
+func (rb *fileRulebook) MultipleTargetMultiplier() int {
+	return rb.multipleTargetMultiplier
+}
+
+// AddAttackHexCost returns the loaded cost of adding an attack hex, given
+// the current number of hexes.
+func (rb *fileRulebook) AddAttackHexCost(hexes int) (Cost, error) {
+	if hexes == 0 {
+		return 0, fmt.Errorf("hexes is 0")
+	}
+	return Cost(rb.addAttackHexNumerator / hexes), nil
+}
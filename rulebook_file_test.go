@@ -0,0 +1,132 @@
+package ghec
+
+import (
+	"strings"
+	"testing"
+)
+
+// validRulebookDocument returns a rulebookDocument with every required field
+// populated, for tests to mutate into invalid shapes.
+func validRulebookDocument() rulebookDocument {
+	base := make(map[string]int, len(baseEnhancementNames))
+	for name := range baseEnhancementNames {
+		base[name] = 30
+	}
+	return rulebookDocument{
+		MultipleTargetMultiplier: 2,
+		AddAttackHexNumerator:    200,
+		Base:                     base,
+		Levels:                   []int{0, 25, 50, 75, 100, 125, 150, 175, 200},
+		Previous:                 []int{0, 75, 150, 225},
+	}
+}
+
+func TestNewFileRulebookValid(t *testing.T) {
+	rb, err := newFileRulebook(validRulebookDocument())
+	if err != nil {
+		t.Fatalf("newFileRulebook: %v", err)
+	}
+	cost, err := rb.BaseCost(EnhanceAttack)
+	if err != nil {
+		t.Fatalf("BaseCost: %v", err)
+	}
+	if cost != 30 {
+		t.Errorf("BaseCost(EnhanceAttack) = %d, want 30", cost)
+	}
+}
+
+func TestNewFileRulebookReportsEveryProblem(t *testing.T) {
+	doc := validRulebookDocument()
+	delete(doc.Base, "Move")               // missing base cost
+	doc.Base["NotARealEnhancement"] = 10   // unknown name
+	doc.Base["Attack"] = -5                // negative base cost
+	doc.Levels = []int{0, 25, 50}          // wrong length
+	doc.Previous = []int{0, -75, 150, 225} // negative previous cost
+	doc.MultipleTargetMultiplier = 0       // non-positive
+	doc.AddAttackHexNumerator = -1         // non-positive
+
+	_, err := newFileRulebook(doc)
+	if err == nil {
+		t.Fatal("newFileRulebook: got nil error, want *RulebookValidationError")
+	}
+	validationErr, ok := err.(*RulebookValidationError)
+	if !ok {
+		t.Fatalf("error type = %T, want *RulebookValidationError", err)
+	}
+
+	wantSubstrings := []string{
+		`missing base cost for "Move"`,
+		`unknown base enhancement name "NotARealEnhancement"`,
+		`negative base cost for "Attack": -5`,
+		"levels must have 9 entries",
+		"negative previous-enhancement cost for 1 previous: -75",
+		"multiple_target_multiplier must be positive",
+		"add_attack_hex_numerator must be positive",
+	}
+	for _, want := range wantSubstrings {
+		found := false
+		for _, problem := range validationErr.Problems {
+			if strings.Contains(problem, want) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Errorf("Problems %v missing substring %q", validationErr.Problems, want)
+		}
+	}
+}
+
+func TestLoadRulebookTOML(t *testing.T) {
+	doc := `
+multiple_target_multiplier = 2
+add_attack_hex_numerator = 200
+levels = [0, 25, 50, 75, 100, 125, 150, 175, 200]
+previous = [0, 75, 150, 225]
+
+[base]
+Move = 30
+Jump = 50
+Attack = 50
+Range = 30
+Target = 50
+Heal = 30
+Shield = 100
+Retaliate = 100
+Strengthen = 50
+Muddle = 50
+Disarm = 150
+Pierce = 30
+Poison = 75
+Wound = 75
+Push = 30
+Pull = 30
+Immobilize = 100
+Curse = 75
+Bless = 50
+SpecificElement = 100
+AnyElement = 150
+SummonsMove = 100
+SummonsAttack = 100
+SummonsRange = 50
+SummonsHP = 50
+`
+	rb, err := LoadRulebook(strings.NewReader(doc))
+	if err != nil {
+		t.Fatalf("LoadRulebook: %v", err)
+	}
+	cost, err := rb.BaseCost(EnhanceShield)
+	if err != nil {
+		t.Fatalf("BaseCost: %v", err)
+	}
+	if cost != 100 {
+		t.Errorf("BaseCost(EnhanceShield) = %d, want 100", cost)
+	}
+}
+
+func TestLoadRulebookInvalidDocumentError(t *testing.T) {
+	_, err := LoadRulebook(strings.NewReader("not valid toml or json {{{"))
+	if err == nil {
+		t.Fatal("LoadRulebook: got nil error for unparseable input")
+	}
+}
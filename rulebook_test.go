@@ -0,0 +1,163 @@
+package ghec
+
+import "testing"
+
+func TestGloomhavenRulesetBaseCost(t *testing.T) {
+	tests := []struct {
+		be   BaseEnhancement
+		want Cost
+	}{
+		{EnhanceMove, 30},
+		{EnhanceAttack, 50},
+		{EnhanceShield, 100},
+		{EnhanceDisarm, 150},
+		{EnhanceSpecificElement, 100},
+		{EnhanceAnyElement, 150},
+		{EnhanceSummonsHP, 50},
+	}
+	rb := GloomhavenRuleset{}
+	for _, tt := range tests {
+		got, err := rb.BaseCost(tt.be)
+		if err != nil {
+			t.Errorf("BaseCost(%d): %v", tt.be, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("BaseCost(%d) = %d, want %d", tt.be, got, tt.want)
+		}
+	}
+}
+
+func TestGloomhavenRulesetLevelCost(t *testing.T) {
+	tests := []struct {
+		level Level
+		want  Cost
+	}{
+		{Level1, 0},
+		{Level2, 25},
+		{Level4, 75},
+		{Level9, 200},
+	}
+	rb := GloomhavenRuleset{}
+	for _, tt := range tests {
+		got, err := rb.LevelCost(tt.level)
+		if err != nil {
+			t.Errorf("LevelCost(%d): %v", tt.level, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("LevelCost(%d) = %d, want %d", tt.level, got, tt.want)
+		}
+	}
+}
+
+func TestGloomhavenRulesetPreviousCost(t *testing.T) {
+	tests := []struct {
+		previous PreviousEnhancements
+		want     Cost
+	}{
+		{PreviousEnhancements0, 0},
+		{PreviousEnhancements1, 75},
+		{PreviousEnhancements2, 150},
+		{PreviousEnhancements3, 225},
+	}
+	rb := GloomhavenRuleset{}
+	for _, tt := range tests {
+		got, err := rb.PreviousCost(tt.previous)
+		if err != nil {
+			t.Errorf("PreviousCost(%d): %v", tt.previous, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("PreviousCost(%d) = %d, want %d", tt.previous, got, tt.want)
+		}
+	}
+}
+
+func TestGloomhavenRulesetMultipleTargetMultiplierAndAddAttackHexCost(t *testing.T) {
+	rb := GloomhavenRuleset{}
+	if got := rb.MultipleTargetMultiplier(); got != 2 {
+		t.Errorf("MultipleTargetMultiplier() = %d, want 2", got)
+	}
+	got, err := rb.AddAttackHexCost(2)
+	if err != nil {
+		t.Fatalf("AddAttackHexCost(2): %v", err)
+	}
+	if got != 100 {
+		t.Errorf("AddAttackHexCost(2) = %d, want 100", got)
+	}
+	if _, err := rb.AddAttackHexCost(0); err == nil {
+		t.Error("AddAttackHexCost(0): got nil error, want error")
+	}
+}
+
+func TestFrosthavenRulesetBaseCost(t *testing.T) {
+	tests := []struct {
+		be   BaseEnhancement
+		want Cost
+	}{
+		{EnhanceSpecificElement, 75}, // cheaper than Gloomhaven's 100
+		{EnhanceAnyElement, 125},     // cheaper than Gloomhaven's 150
+		{EnhanceMove, 30},            // matches Gloomhaven for non-elemental costs
+		{EnhanceAttack, 50},          // matches Gloomhaven for non-elemental costs
+	}
+	rb := FrosthavenRuleset{}
+	for _, tt := range tests {
+		got, err := rb.BaseCost(tt.be)
+		if err != nil {
+			t.Errorf("BaseCost(%d): %v", tt.be, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("BaseCost(%d) = %d, want %d", tt.be, got, tt.want)
+		}
+	}
+}
+
+func TestFrosthavenRulesetLevelCost(t *testing.T) {
+	rb := FrosthavenRuleset{}
+	got, err := rb.LevelCost(Level4)
+	if err != nil {
+		t.Fatalf("LevelCost(Level4): %v", err)
+	}
+	if got != 75 {
+		t.Errorf("LevelCost(Level4) = %d, want 75", got)
+	}
+}
+
+func TestFrosthavenRulesetPreviousCost(t *testing.T) {
+	tests := []struct {
+		previous PreviousEnhancements
+		want     Cost
+	}{
+		{PreviousEnhancements0, 0},
+		{PreviousEnhancements1, 100},
+		{PreviousEnhancements2, 200},
+		{PreviousEnhancements3, 300},
+	}
+	rb := FrosthavenRuleset{}
+	for _, tt := range tests {
+		got, err := rb.PreviousCost(tt.previous)
+		if err != nil {
+			t.Errorf("PreviousCost(%d): %v", tt.previous, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("PreviousCost(%d) = %d, want %d", tt.previous, got, tt.want)
+		}
+	}
+}
+
+func TestFrosthavenRulesetMultipleTargetMultiplierAndAddAttackHexCost(t *testing.T) {
+	rb := FrosthavenRuleset{}
+	if got := rb.MultipleTargetMultiplier(); got != 2 {
+		t.Errorf("MultipleTargetMultiplier() = %d, want 2", got)
+	}
+	got, err := rb.AddAttackHexCost(2)
+	if err != nil {
+		t.Fatalf("AddAttackHexCost(2): %v", err)
+	}
+	if got != 100 {
+		t.Errorf("AddAttackHexCost(2) = %d, want 100", got)
+	}
+}